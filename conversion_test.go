@@ -1,6 +1,8 @@
 package jsonmerge
 
 import (
+	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -57,3 +59,95 @@ func TestScalarDocumentsPreserveNamedType(t *testing.T) {
 		assert.JSONEq(t, `2`, string(data))
 	})
 }
+
+func TestBigIntFieldsPreserveArbitraryPrecision(t *testing.T) {
+	t.Parallel()
+
+	type Measurement struct {
+		Count *big.Int `json:"count"`
+	}
+
+	count, ok := new(big.Int).SetString("1234567890123456789012345678901234567890", 10)
+	require.True(t, ok)
+	patch := mustParsePatch(t, `{"count":1234567890123456789012345678901234567891}`)
+
+	got, err := Apply(Measurement{Count: count}, patch)
+	require.NoError(t, err)
+
+	want, ok := new(big.Int).SetString("1234567890123456789012345678901234567891", 10)
+	require.True(t, ok)
+	assert.Equal(t, 0, want.Cmp(got.Count))
+}
+
+func TestIntegerKeyedMapsUseJSONMemberNames(t *testing.T) {
+	t.Parallel()
+
+	t.Run("apply", func(t *testing.T) {
+		t.Parallel()
+
+		patch := mustNewPatch(t, map[string]any{"2": nil, "3": "c"})
+		got, err := Apply(map[int]string{1: "a", 2: "b"}, patch)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[int]string{1: "a", 3: "c"}, got)
+	})
+
+	t.Run("non-integer member fails projection", func(t *testing.T) {
+		t.Parallel()
+
+		patch := mustNewPatch(t, map[string]any{"x": "c"})
+		_, err := Apply(map[int]string{1: "a"}, patch)
+		require.ErrorIs(t, err, ErrCannotRepresent)
+	})
+}
+
+type foldedKey string
+
+func (k foldedKey) MarshalText() ([]byte, error) {
+	return []byte(strings.ToLower(string(k))), nil
+}
+
+func TestMapKeysCollidingAsMemberNamesFail(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewPatch(map[foldedKey]int{"ID": 1, "id": 2})
+	require.ErrorIs(t, err, ErrInvalidValue)
+}
+
+func TestPointerTargetsProjectIntoNewValues(t *testing.T) {
+	t.Parallel()
+
+	type User struct {
+		Name string `json:"name"`
+		Age  int    `json:"age,omitempty"`
+	}
+
+	t.Run("struct pointer", func(t *testing.T) {
+		t.Parallel()
+
+		target := &User{Name: "John", Age: 30}
+		got, err := Apply(target, mustNewPatch(t, map[string]any{"name": "Jane"}))
+		require.NoError(t, err)
+
+		assert.Equal(t, &User{Name: "Jane", Age: 30}, got)
+		assert.Equal(t, &User{Name: "John", Age: 30}, target)
+	})
+
+	t.Run("nil pointer is null", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := Apply((*User)(nil), mustNewPatch(t, map[string]any{"name": "Jane", "age": 25}))
+		require.NoError(t, err)
+
+		assert.Equal(t, &User{Name: "Jane", Age: 25}, got)
+	})
+
+	t.Run("null patch", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := Apply(&User{Name: "John"}, Patch{})
+		require.NoError(t, err)
+
+		assert.Nil(t, got)
+	})
+}
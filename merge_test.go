@@ -4,6 +4,7 @@ import (
 	"errors"
 	"math"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/go-json-experiment/json"
@@ -156,6 +157,51 @@ func TestApplyRFCAppendixA(t *testing.T) {
 	}
 }
 
+func TestApplyIsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		target string
+		patch  string
+	}{
+		{
+			name:   "nested object merge",
+			target: `{"a":{"b":"c","d":1}}`,
+			patch:  `{"a":{"b":"d","c":null},"e":[1,2]}`,
+		},
+		{
+			name:   "delete missing member",
+			target: `{"a":"b"}`,
+			patch:  `{"c":null}`,
+		},
+		{
+			name:   "array patch",
+			target: `{"a":"b"}`,
+			patch:  `["c"]`,
+		},
+		{
+			name:   "null patch",
+			target: `{"a":"b"}`,
+			patch:  `null`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			patch := mustParsePatch(t, tt.patch)
+			once, err := Apply(JSON(tt.target), patch)
+			require.NoError(t, err)
+			twice, err := Apply(once, patch)
+			require.NoError(t, err)
+
+			assert.Equal(t, once, twice)
+		})
+	}
+}
+
 func TestStringDocumentsAreScalars(t *testing.T) {
 	t.Parallel()
 
@@ -207,6 +253,23 @@ func TestJSONTextDocumentsAreExplicit(t *testing.T) {
 	assert.JSONEq(t, `{"name":"Jane","age":30}`, string(got))
 }
 
+func TestMemberNamesAreOpaqueStrings(t *testing.T) {
+	t.Parallel()
+
+	target := JSON(`{"[1,2]":"a","{\"k\":1}":"b"}`)
+	patch := mustParsePatch(t, `{"[1, 2]":"c","{\"k\": 1}":null}`)
+
+	got, err := Apply(target, patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"[1,2]":"a","[1, 2]":"c","{\"k\":1}":"b"}`, string(got))
+
+	diff, err := Diff(JSON(`{"[1,2]":"a"}`), JSON(`{"[1, 2]":"a"}`))
+	require.NoError(t, err)
+	data, err := diff.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"[1,2]":null,"[1, 2]":"a"}`, string(data))
+}
+
 func TestEncodedJSONNumbersDoNotLosePrecision(t *testing.T) {
 	t.Parallel()
 
@@ -218,6 +281,10 @@ func TestEncodedJSONNumbersDoNotLosePrecision(t *testing.T) {
 			name: "large integer",
 			want: `{"id":9007199254740993}`,
 		},
+		{
+			name: "40-digit integer",
+			want: `{"id":1234567890123456789012345678901234567890}`,
+		},
 		{
 			name: "long decimal",
 			want: `{"amount":0.12345678901234567890123456789}`,
@@ -240,6 +307,16 @@ func TestEncodedJSONNumbersDoNotLosePrecision(t *testing.T) {
 	}
 }
 
+func TestEncodedJSONNumbersKeepLiteralForm(t *testing.T) {
+	t.Parallel()
+
+	patch := mustParsePatch(t, `{"changed":2.50}`)
+	got, err := Apply(JSON(`{"decimal":1.0,"exponent":1e3,"integer":100,"changed":2}`), patch)
+	require.NoError(t, err)
+
+	assert.Equal(t, JSON(`{"changed":2.50,"decimal":1.0,"exponent":1e3,"integer":100}`), got)
+}
+
 func TestPatchMarshalJSONIsStable(t *testing.T) {
 	t.Parallel()
 
@@ -252,6 +329,26 @@ func TestPatchMarshalJSONIsStable(t *testing.T) {
 	}
 }
 
+func TestEncodedOutputSortsMembersInsideArrays(t *testing.T) {
+	t.Parallel()
+
+	patch := mustNewPatch(t, map[string]any{
+		"items": []any{
+			map[string]any{"z": 1, "y": map[string]any{"b": 2, "a": 1}},
+			[]any{map[string]any{"d": 4, "c": 3}},
+		},
+	})
+	want := `{"items":[{"y":{"a":1,"b":2},"z":1},[{"c":3,"d":4}]]}`
+
+	data, err := patch.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, want, string(data))
+
+	got, err := Apply([]byte(`{}`), patch)
+	require.NoError(t, err)
+	assert.Equal(t, want, string(got))
+}
+
 func TestDiffPreservesEncodedJSONNumbers(t *testing.T) {
 	t.Parallel()
 
@@ -484,6 +581,11 @@ func TestDiffUsesNormalizedJSONEquality(t *testing.T) {
 			source: counter{N: 1},
 			target: []byte(`{"n":1}`),
 		},
+		{
+			name:   "reordered json text members",
+			source: JSON(`{"a":1,"b":{"c":2,"d":[{"e":3,"f":4}]}}`),
+			target: []byte(`{"b":{"d":[{"f":4,"e":3}],"c":2},"a":1}`),
+		},
 	}
 
 	for _, tt := range tests {
@@ -767,6 +869,74 @@ func TestApplyDoesNotAliasPatchValues(t *testing.T) {
 	}
 }
 
+func TestPatchIsSafeForConcurrentApply(t *testing.T) {
+	t.Parallel()
+
+	patch := mustNewPatch(t, map[string]any{
+		"profile": map[string]any{
+			"theme": "light",
+			"flags": []any{"beta"},
+		},
+	})
+
+	results := make([]map[string]any, 16)
+	errs := make([]error, len(results))
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Go(func() {
+			target := map[string]any{"id": i, "profile": map[string]any{"theme": "dark"}}
+			results[i], errs[i] = Apply(target, patch)
+			if errs[i] == nil {
+				results[i]["profile"].(map[string]any)["flags"].([]any)[0] = "changed"
+			}
+		})
+	}
+	wg.Wait()
+
+	for i, got := range results {
+		require.NoError(t, errs[i])
+		assert.JSONEq(t, mustMarshalJSON(t, map[string]any{
+			"id":      i,
+			"profile": map[string]any{"theme": "light", "flags": []any{"changed"}},
+		}), mustMarshalJSON(t, got))
+	}
+
+	data, err := patch.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"profile":{"flags":["beta"],"theme":"light"}}`, string(data))
+}
+
+func TestApplyDoesNotAliasUntouchedTargetSlices(t *testing.T) {
+	t.Parallel()
+
+	target := map[string]any{
+		"name": "John",
+		"profile": map[string]any{
+			"flags": []any{
+				map[string]any{"name": "old"},
+			},
+		},
+	}
+	patch := mustNewPatch(t, map[string]any{"name": "Jane"})
+
+	got, err := Apply(target, patch)
+	require.NoError(t, err)
+
+	got["profile"].(map[string]any)["flags"].([]any)[0].(map[string]any)["name"] = "changed"
+
+	want := map[string]any{
+		"name": "John",
+		"profile": map[string]any{
+			"flags": []any{
+				map[string]any{"name": "old"},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, target); diff != "" {
+		t.Fatalf("Apply() result aliased target slice elements (-want +got):\n%s", diff)
+	}
+}
+
 func TestDiffPatchDoesNotAliasInputsOrResults(t *testing.T) {
 	t.Parallel()
 
@@ -831,9 +1001,48 @@ func TestCloneJSONValueCopiesCanonicalContainers(t *testing.T) {
 func TestInvalidGoValueFails(t *testing.T) {
 	t.Parallel()
 
-	_, err := NewPatch(map[string]any{"limit": math.NaN()})
-	require.Error(t, err)
-	require.ErrorIs(t, err, ErrInvalidValue)
+	emptyPatch := mustParsePatch(t, `{}`)
+	tests := []struct {
+		name string
+		run  func() error
+	}{
+		{
+			name: "nan in patch",
+			run: func() error {
+				_, err := NewPatch(map[string]any{"limit": math.NaN()})
+				return err
+			},
+		},
+		{
+			name: "infinity inside patch array",
+			run: func() error {
+				_, err := NewPatch(map[string]any{"limits": []any{1.0, math.Inf(1)}})
+				return err
+			},
+		},
+		{
+			name: "negative infinity in apply target",
+			run: func() error {
+				_, err := Apply(map[string]any{"limit": math.Inf(-1)}, emptyPatch)
+				return err
+			},
+		},
+		{
+			name: "nan in diff target",
+			run: func() error {
+				_, err := Diff(map[string]any{}, map[string]any{"nested": map[string]any{"limit": math.NaN()}})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.ErrorIs(t, tt.run(), ErrInvalidValue)
+		})
+	}
 }
 
 func TestMapProjectionRejectsNonObjectResults(t *testing.T) {
@@ -845,6 +1054,17 @@ func TestMapProjectionRejectsNonObjectResults(t *testing.T) {
 	require.ErrorIs(t, err, ErrCannotRepresent)
 }
 
+func TestNullPatchClearsMapTarget(t *testing.T) {
+	t.Parallel()
+
+	target := map[string]any{"name": "John"}
+	got, err := Apply(target, Patch{})
+	require.NoError(t, err)
+
+	assert.Nil(t, got)
+	assert.Equal(t, map[string]any{"name": "John"}, target)
+}
+
 func BenchmarkApplyMap(b *testing.B) {
 	target := map[string]any{
 		"name": "John",
@@ -871,6 +1091,42 @@ func BenchmarkApplyMap(b *testing.B) {
 	}
 }
 
+func BenchmarkApplyStruct(b *testing.B) {
+	type Limits struct {
+		Requests int `json:"requests"`
+	}
+	type Profile struct {
+		Active bool   `json:"active"`
+		Limits Limits `json:"limits"`
+	}
+	type User struct {
+		Name    string  `json:"name"`
+		Profile Profile `json:"profile"`
+	}
+
+	target := User{
+		Name: "John",
+		Profile: Profile{
+			Active: true,
+			Limits: Limits{Requests: 100},
+		},
+	}
+	patch := mustNewPatch(b, map[string]any{
+		"profile": map[string]any{
+			"limits": map[string]any{
+				"requests": 200,
+			},
+		},
+	})
+
+	b.ResetTimer()
+	for b.Loop() {
+		if _, err := Apply(target, patch); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func BenchmarkDiffMap(b *testing.B) {
 	source := map[string]any{
 		"name": "John",